@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+/**
+* @brief: Backend selects which Cache implementation NewCache builds.
+**/
+
+type Backend string
+
+const (
+	BackendNone   Backend = ""
+	BackendMemory Backend = "memory"
+	BackendRedis  Backend = "redis"
+)
+
+/**
+* @brief: CacheConfig controls which backend backs a DBResources' read-through cache and
+* how long entries live in it.
+**/
+
+type CacheConfig struct {
+	Backend Backend
+	TTL     time.Duration
+
+	// MaxEntries bounds the in-memory LRU. Ignored by the redis backend.
+	MaxEntries int
+
+	// RedisAddr/RedisPassword/RedisDB configure the redis backend. Ignored by memory.
+	RedisAddr     string
+	RedisPassword string
+	RedisDB       int
+}
+
+const defaultTTL = time.Minute
+
+/**
+* @brief: Stats reports how a Cache has been performing since it was created.
+**/
+
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+/**
+* @brief: HitRate returns Hits/(Hits+Misses), or 0 if the cache has never been queried.
+**/
+
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+/**
+* @brief: Cache is a pluggable read-through cache for byte-slice values keyed by a
+* caller-chosen string (e.g. "last-contest:<email>:<platform>"). Implementations must be
+* safe for concurrent use.
+**/
+
+type Cache interface {
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, key string) error
+	Stats() Stats
+}
+
+/**
+* @brief: NewCache builds the Cache matching config.Backend. Passing the zero value
+* (BackendNone) yields a nil Cache, which callers should treat as "caching disabled".
+* @param: config - which backend to build and how to tune it.
+* @return: a ready-to-use Cache, or an error if the backend is unknown or misconfigured.
+**/
+
+func NewCache(config CacheConfig) (Cache, error) {
+	if config.TTL <= 0 {
+		config.TTL = defaultTTL
+	}
+	switch config.Backend {
+	case BackendNone:
+		return nil, nil
+	case BackendMemory:
+		return newMemoryCache(config), nil
+	case BackendRedis:
+		return newRedisCache(config)
+	default:
+		return nil, fmt.Errorf("cache: unknown backend %q", config.Backend)
+	}
+}
@@ -0,0 +1,111 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/**
+* @brief: memoryEntry is one value stored in a memoryCache, alongside the time it expires.
+**/
+
+type memoryEntry struct {
+	value    []byte
+	expireAt time.Time
+}
+
+func (e memoryEntry) expired(now time.Time) bool {
+	return now.After(e.expireAt)
+}
+
+/**
+* @brief: memoryCache is an in-process TTL cache with a bounded entry count. Once
+* MaxEntries is reached, the oldest entry (by insertion, not access) is evicted to make
+* room - a plain LRU-by-insertion rather than a full access-order LRU, which is all the
+* cron-job read-through pattern here needs.
+**/
+
+type memoryCache struct {
+	mu         sync.Mutex
+	entries    map[string]memoryEntry
+	order      []string
+	maxEntries int
+	defaultTTL time.Duration
+	hits       uint64
+	misses     uint64
+}
+
+func newMemoryCache(cfg CacheConfig) *memoryCache {
+	maxEntries := cfg.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = 10000
+	}
+	return &memoryCache{
+		entries:    make(map[string]memoryEntry),
+		maxEntries: maxEntries,
+		defaultTTL: cfg.TTL,
+	}
+}
+
+func (c *memoryCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || entry.expired(time.Now()) {
+		atomic.AddUint64(&c.misses, 1)
+		if ok {
+			delete(c.entries, key)
+			for i, existing := range c.order {
+				if existing == key {
+					c.order = append(c.order[:i], c.order[i+1:]...)
+					break
+				}
+			}
+		}
+		return nil, false, nil
+	}
+	atomic.AddUint64(&c.hits, 1)
+	return entry.value, true, nil
+}
+
+func (c *memoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl <= 0 {
+		ttl = c.defaultTTL
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.entries[key]; !exists {
+		if len(c.order) >= c.maxEntries {
+			oldest := c.order[0]
+			c.order = c.order[1:]
+			delete(c.entries, oldest)
+		}
+		c.order = append(c.order, key)
+	}
+	c.entries[key] = memoryEntry{value: value, expireAt: time.Now().Add(ttl)}
+	return nil
+}
+
+func (c *memoryCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+	for i, existing := range c.order {
+		if existing == key {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (c *memoryCache) Stats() Stats {
+	return Stats{
+		Hits:   atomic.LoadUint64(&c.hits),
+		Misses: atomic.LoadUint64(&c.misses),
+	}
+}
@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+/**
+* @brief: redisCache is the optional Cache backend for operators who want the cache
+* shared across multiple serviceUtilities processes instead of kept per-process.
+**/
+
+type redisCache struct {
+	client     *redis.Client
+	defaultTTL time.Duration
+	hits       uint64
+	misses     uint64
+}
+
+func newRedisCache(cfg CacheConfig) (*redisCache, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.RedisAddr,
+		Password: cfg.RedisPassword,
+		DB:       cfg.RedisDB,
+	})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		return nil, fmt.Errorf("cache: couldnt connect to redis: %w", err)
+	}
+	return &redisCache{client: client, defaultTTL: cfg.TTL}, nil
+}
+
+func (c *redisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := c.client.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		atomic.AddUint64(&c.misses, 1)
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("cache: couldnt read from redis: %w", err)
+	}
+	atomic.AddUint64(&c.hits, 1)
+	return value, true, nil
+}
+
+func (c *redisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if ttl <= 0 {
+		ttl = c.defaultTTL
+	}
+	if err := c.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("cache: couldnt write to redis: %w", err)
+	}
+	return nil
+}
+
+func (c *redisCache) Delete(ctx context.Context, key string) error {
+	if err := c.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("cache: couldnt delete from redis: %w", err)
+	}
+	return nil
+}
+
+func (c *redisCache) Stats() Stats {
+	return Stats{
+		Hits:   atomic.LoadUint64(&c.hits),
+		Misses: atomic.LoadUint64(&c.misses),
+	}
+}
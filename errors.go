@@ -0,0 +1,14 @@
+package serviceUtilities
+
+import "errors"
+
+/**
+* @brief: Sentinel errors returned by the Ctx-suffixed functions so callers can branch
+* with errors.Is instead of matching on error message text.
+**/
+
+var (
+	ErrUserNotFound    = errors.New("serviceUtilities: user not found")
+	ErrUnknownPlatform = errors.New("serviceUtilities: unknown platform")
+	ErrNoData          = errors.New("serviceUtilities: no data for platform")
+)
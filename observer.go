@@ -0,0 +1,164 @@
+package serviceUtilities
+
+import (
+	"fmt"
+	"sync"
+)
+
+/**
+* @brief: PlatformDataObserver is notified whenever new contest or submission data is
+* appended for a user/platform pair. Implementations should return quickly; slow work
+* should be handed off to its own goroutine so it cant block the dispatch loop.
+**/
+
+type PlatformDataObserver interface {
+	OnContestsAppended(email string, platform string, new []ContestData)
+	OnSubmissionsAppended(email string, platform string, new []SubmissionData)
+}
+
+/**
+* @brief: observerEvent is the unit of work pushed onto DBResources.observerEvents and
+* drained by the dispatch goroutine started in OpenDatabaseConnection.
+**/
+
+type observerEvent struct {
+	email       string
+	platform    string
+	contests    []ContestData
+	submissions []SubmissionData
+}
+
+const observerQueueSize = 256
+
+/**
+* @brief: observerState holds the mutable subscriber list behind a pointer so it stays
+* shared across every DBResources value copied off the one OpenDatabaseConnection
+* returned - DBResources itself is always passed by value, so a bare sync.Mutex/slice
+* pair on the struct would only ever be mutated on the caller's own copy and never seen
+* by the dispatch goroutine's copy. mu also guards closed, so that notifying and closing
+* the observerEvents channel can't race: without that, a notify call losing the race to
+* CloseDatabaseConnection would send on an already-closed channel and panic, since a
+* select with a default case only avoids blocking, not a closed-channel send.
+**/
+
+type observerState struct {
+	mu        sync.Mutex
+	observers []PlatformDataObserver
+	closed    bool
+}
+
+/**
+* @brief: Subscribe registers obs to receive future contest/submission append events.
+* @param: obs - the observer to register.
+* @return: None.
+**/
+
+func (dbResources DBResources) Subscribe(obs PlatformDataObserver) {
+	dbResources.observerState.mu.Lock()
+	defer dbResources.observerState.mu.Unlock()
+	dbResources.observerState.observers = append(dbResources.observerState.observers, obs)
+}
+
+/**
+* @brief: Unsubscribe removes obs so it no longer receives append events. It is a no-op
+* if obs was never subscribed.
+* @param: obs - the observer to remove.
+* @return: None.
+**/
+
+func (dbResources DBResources) Unsubscribe(obs PlatformDataObserver) {
+	dbResources.observerState.mu.Lock()
+	defer dbResources.observerState.mu.Unlock()
+	for i, existing := range dbResources.observerState.observers {
+		if existing == obs {
+			dbResources.observerState.observers = append(dbResources.observerState.observers[:i], dbResources.observerState.observers[i+1:]...)
+			return
+		}
+	}
+}
+
+/**
+* @brief: notifyContestsAppended queues a contest-append event for the dispatch goroutine.
+* It never blocks the caller: if the queue is full the event is dropped and logged, since
+* losing a notification is preferable to stalling a Mongo write.
+**/
+
+func (dbResources DBResources) notifyContestsAppended(email string, platform string, new []ContestData) {
+	if dbResources.observerEvents == nil {
+		return
+	}
+	dbResources.observerState.mu.Lock()
+	defer dbResources.observerState.mu.Unlock()
+	if dbResources.observerState.closed {
+		return
+	}
+	select {
+	case dbResources.observerEvents <- observerEvent{email: email, platform: platform, contests: new}:
+	default:
+		fmt.Println("Observer event queue full, dropping contest-append notification")
+	}
+}
+
+/**
+* @brief: notifySubmissionsAppended queues a submission-append event for the dispatch
+* goroutine. Same drop-on-full semantics as notifyContestsAppended.
+**/
+
+func (dbResources DBResources) notifySubmissionsAppended(email string, platform string, new []SubmissionData) {
+	if dbResources.observerEvents == nil {
+		return
+	}
+	dbResources.observerState.mu.Lock()
+	defer dbResources.observerState.mu.Unlock()
+	if dbResources.observerState.closed {
+		return
+	}
+	select {
+	case dbResources.observerEvents <- observerEvent{email: email, platform: platform, submissions: new}:
+	default:
+		fmt.Println("Observer event queue full, dropping submission-append notification")
+	}
+}
+
+/**
+* @brief: closeObserverEvents marks observerState closed and closes observerEvents under
+* the same lock notify*Appended take, so any notify call that loses the race just sees
+* closed and returns instead of sending on the now-closed channel.
+**/
+
+func (dbResources DBResources) closeObserverEvents() {
+	if dbResources.observerEvents == nil {
+		return
+	}
+	dbResources.observerState.mu.Lock()
+	defer dbResources.observerState.mu.Unlock()
+	if dbResources.observerState.closed {
+		return
+	}
+	dbResources.observerState.closed = true
+	close(dbResources.observerEvents)
+}
+
+/**
+* @brief: dispatchObserverEvents is the single goroutine that fans events out to every
+* subscribed observer. Running fan-out on one goroutine means a slow subscriber only
+* delays other subscribers, never the DB writes that produced the event.
+**/
+
+func (dbResources DBResources) dispatchObserverEvents() {
+	for event := range dbResources.observerEvents {
+		dbResources.observerState.mu.Lock()
+		observers := make([]PlatformDataObserver, len(dbResources.observerState.observers))
+		copy(observers, dbResources.observerState.observers)
+		dbResources.observerState.mu.Unlock()
+
+		for _, obs := range observers {
+			if event.contests != nil {
+				obs.OnContestsAppended(event.email, event.platform, event.contests)
+			}
+			if event.submissions != nil {
+				obs.OnSubmissionsAppended(event.email, event.platform, event.submissions)
+			}
+		}
+	}
+}
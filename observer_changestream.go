@@ -0,0 +1,165 @@
+package serviceUtilities
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+/**
+* @brief: ChangeStreamWatcher tails a MongoDB Change Stream on the users collection so
+* that PlatformDataObserver subscribers in *other* processes - ones that never called
+* AppendContestData/AppendSubmissionData themselves - still see every append. It keeps
+* its own subscriber list because it has no relationship to the DBResources that
+* performed the write.
+**/
+
+type ChangeStreamWatcher struct {
+	collection *mongo.Collection
+	observerMu sync.Mutex
+	observers  []PlatformDataObserver
+	seenLen    map[string]int
+}
+
+/**
+* @brief: NewChangeStreamWatcher builds a watcher over the same collection used by
+* dbResources.
+* @param: dbResources - an open connection whose collection should be tailed.
+* @return: a ChangeStreamWatcher ready to Subscribe and Watch.
+**/
+
+func NewChangeStreamWatcher(dbResources DBResources) *ChangeStreamWatcher {
+	return &ChangeStreamWatcher{
+		collection: dbResources.selectedCollection,
+		seenLen:    make(map[string]int),
+	}
+}
+
+/**
+* @brief: Subscribe registers obs to receive events observed via the change stream.
+**/
+
+func (w *ChangeStreamWatcher) Subscribe(obs PlatformDataObserver) {
+	w.observerMu.Lock()
+	defer w.observerMu.Unlock()
+	w.observers = append(w.observers, obs)
+}
+
+/**
+* @brief: Unsubscribe removes obs from the change stream watcher.
+**/
+
+func (w *ChangeStreamWatcher) Unsubscribe(obs PlatformDataObserver) {
+	w.observerMu.Lock()
+	defer w.observerMu.Unlock()
+	for i, existing := range w.observers {
+		if existing == obs {
+			w.observers = append(w.observers[:i], w.observers[i+1:]...)
+			return
+		}
+	}
+}
+
+/**
+* @brief: Watch opens the change stream and blocks, dispatching to subscribers until ctx
+* is cancelled or the stream errors out.
+* @param: ctx - controls the lifetime of the watch loop.
+* @return: nil if ctx was cancelled, otherwise the error that ended the stream.
+**/
+
+func (w *ChangeStreamWatcher) Watch(ctx context.Context) error {
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{
+			{Key: "operationType", Value: "update"},
+		}}},
+	}
+	stream, err := w.collection.Watch(ctx, pipeline, options.ChangeStream().SetFullDocument(options.UpdateLookup))
+	if err != nil {
+		return fmt.Errorf("observer: couldnt open change stream: %w", err)
+	}
+	defer stream.Close(ctx)
+
+	for stream.Next(ctx) {
+		var event struct {
+			FullDocument bson.M `bson:"fullDocument"`
+		}
+		if err := stream.Decode(&event); err != nil {
+			fmt.Printf("observer: couldnt decode change event: %v\n", err)
+			continue
+		}
+		w.handleFullDocument(event.FullDocument)
+	}
+	if err := stream.Err(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("observer: change stream ended: %w", err)
+	}
+	return nil
+}
+
+/**
+* @brief: handleFullDocument diffs the updated document's contests/submissions against
+* the lengths last seen for that email/platform, and notifies subscribers about the
+* newly appended tail so a $push shows up as an OnContestsAppended/OnSubmissionsAppended
+* call rather than the whole array.
+**/
+
+func (w *ChangeStreamWatcher) handleFullDocument(fullDocument bson.M) {
+	if fullDocument == nil {
+		return
+	}
+	doc, err := bson.Marshal(fullDocument)
+	if err != nil {
+		fmt.Printf("observer: couldnt marshal change event document: %v\n", err)
+		return
+	}
+	var userObject UserSchema
+	if err := bson.Unmarshal(doc, &userObject); err != nil {
+		fmt.Printf("observer: couldnt unmarshal change event document: %v\n", err)
+		return
+	}
+
+	w.observerMu.Lock()
+	observers := make([]PlatformDataObserver, len(w.observers))
+	copy(observers, w.observers)
+	w.observerMu.Unlock()
+
+	for platform := range PlatformRegistry {
+		platformData, err := GetPlatform(&userObject, platform)
+		if err != nil {
+			continue
+		}
+
+		contestKey := userObject.Email + ":" + string(platform) + ":contests"
+		if _, seen := w.seenLen[contestKey]; !seen {
+			// First sighting of this email/platform: baseline to the current length
+			// instead of diffing against 0, or every pre-existing contest would be
+			// reported as newly appended.
+			w.seenLen[contestKey] = len(platformData.Contests)
+		} else if newContests := platformData.Contests[min(w.seenLen[contestKey], len(platformData.Contests)):]; len(newContests) > 0 {
+			w.seenLen[contestKey] = len(platformData.Contests)
+			for _, obs := range observers {
+				obs.OnContestsAppended(userObject.Email, string(platform), newContests)
+			}
+		}
+
+		submissionKey := userObject.Email + ":" + string(platform) + ":submissions"
+		if _, seen := w.seenLen[submissionKey]; !seen {
+			w.seenLen[submissionKey] = len(platformData.Submissions)
+		} else if newSubmissions := platformData.Submissions[min(w.seenLen[submissionKey], len(platformData.Submissions)):]; len(newSubmissions) > 0 {
+			w.seenLen[submissionKey] = len(platformData.Submissions)
+			for _, obs := range observers {
+				obs.OnSubmissionsAppended(userObject.Email, string(platform), newSubmissions)
+			}
+		}
+	}
+}
+
+func min(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
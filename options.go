@@ -0,0 +1,75 @@
+package serviceUtilities
+
+import (
+	"github.com/NoStalk/serviceUtilities/cache"
+	"github.com/NoStalk/serviceUtilities/storage"
+)
+
+/**
+* @brief: dbConfig collects everything OpenDatabaseConnection can be configured with via
+* Option. defaultDBConfig returns the library's original hard-coded behaviour: database
+* "UserDB", collection "users", no code store, no cache.
+**/
+
+type dbConfig struct {
+	databaseName   string
+	collectionName string
+	storageConfig  storage.StorageConfig
+	cacheConfig    cache.CacheConfig
+}
+
+func defaultDBConfig() dbConfig {
+	return dbConfig{
+		databaseName:   "UserDB",
+		collectionName: "users",
+	}
+}
+
+/**
+* @brief: Option configures OpenDatabaseConnection. See WithDatabaseName,
+* WithCollectionName, WithStorage and WithCache.
+**/
+
+type Option func(*dbConfig)
+
+/**
+* @brief: WithDatabaseName overrides the default "UserDB" Mongo database.
+**/
+
+func WithDatabaseName(name string) Option {
+	return func(cfg *dbConfig) {
+		cfg.databaseName = name
+	}
+}
+
+/**
+* @brief: WithCollectionName overrides the default "users" Mongo collection.
+**/
+
+func WithCollectionName(name string) Option {
+	return func(cfg *dbConfig) {
+		cfg.collectionName = name
+	}
+}
+
+/**
+* @brief: WithStorage configures the CodeArtifactStore backend used by
+* AppendSubmissionDataWithCode. Omit this option to disable code storage.
+**/
+
+func WithStorage(storageConfig storage.StorageConfig) Option {
+	return func(cfg *dbConfig) {
+		cfg.storageConfig = storageConfig
+	}
+}
+
+/**
+* @brief: WithCache configures the read-through cache backing GetLastContestCtx and
+* GetLastSubmissionCtx. Omit this option to disable caching.
+**/
+
+func WithCache(cacheConfig cache.CacheConfig) Option {
+	return func(cfg *dbConfig) {
+		cfg.cacheConfig = cacheConfig
+	}
+}
@@ -0,0 +1,73 @@
+package serviceUtilities
+
+import "fmt"
+
+/**
+* @brief: Platform identifies one of the judges tracked in Platforms. It replaces bare
+* strings so that typos are caught by the registry lookup below instead of surfacing as
+* a reflect panic from a FieldByName lookup.
+**/
+
+type Platform string
+
+const (
+	PlatformLeetcode    Platform = "Leetcode"
+	PlatformCodeforces  Platform = "Codeforces"
+	PlatformCodechef    Platform = "Codechef"
+	PlatformCpoj        Platform = "Cpoj"
+	PlatformHackerearth Platform = "Hackerearth"
+	PlatformAtcoder     Platform = "Atcoder"
+)
+
+/**
+* @brief: platformEntry is one PlatformRegistry row: the lowercase field name used in
+* Mongo bson paths, and an accessor to the matching field of a Platforms struct. Adding a
+* new judge only means adding a new entry here, not editing every call site that used to
+* reflect.FieldByName its way to the right field.
+**/
+
+type platformEntry struct {
+	bsonField string
+	access    func(*Platforms) *PlatformDataModel
+}
+
+var PlatformRegistry = map[Platform]platformEntry{
+	PlatformLeetcode:    {bsonField: "leetcode", access: func(p *Platforms) *PlatformDataModel { return &p.Leetcode }},
+	PlatformCodeforces:  {bsonField: "codeforces", access: func(p *Platforms) *PlatformDataModel { return &p.Codeforces }},
+	PlatformCodechef:    {bsonField: "codechef", access: func(p *Platforms) *PlatformDataModel { return &p.Codechef }},
+	PlatformCpoj:        {bsonField: "cpoj", access: func(p *Platforms) *PlatformDataModel { return &p.Cpoj }},
+	PlatformHackerearth: {bsonField: "hackerearth", access: func(p *Platforms) *PlatformDataModel { return &p.Hackerearth }},
+	PlatformAtcoder:     {bsonField: "atcoder", access: func(p *Platforms) *PlatformDataModel { return &p.Atcoder }},
+}
+
+/**
+* @brief: GetPlatform looks up the PlatformDataModel for p on u. It accepts any
+* string-based type so callers can pass either a Platform constant or a raw string
+* without an explicit conversion.
+* @param: u - the user to read from, p - the platform to look up.
+* @return: a pointer into u.PlatformData for the matching platform, or an error if p
+* isnt a registered platform.
+**/
+
+func GetPlatform[T ~string](u *UserSchema, p T) (*PlatformDataModel, error) {
+	entry, ok := PlatformRegistry[Platform(p)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownPlatform, string(p))
+	}
+	return entry.access(&u.PlatformData), nil
+}
+
+/**
+* @brief: bsonFieldFor resolves the lowercase bson field name for platform, used to build
+* "platformData.<field>.contests"/".submissions" projection and update paths.
+* @param: platform - the platform to look up.
+* @return: the bson field name, or an error if platform isnt registered.
+**/
+
+func bsonFieldFor(platform string) (string, error) {
+	entry, ok := PlatformRegistry[Platform(platform)]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownPlatform, platform)
+	}
+	return entry.bsonField, nil
+}
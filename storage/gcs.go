@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/storage"
+)
+
+/**
+* @brief: gcsStore is the CodeArtifactStore backend for Google Cloud Storage.
+**/
+
+type gcsStore struct {
+	client *storage.Client
+	bucket string
+}
+
+func newGCSStore(cfg StorageConfig) (*gcsStore, error) {
+	client, err := storage.NewClient(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("storage: couldnt create gcs client: %w", err)
+	}
+	return &gcsStore{client: client, bucket: cfg.Bucket}, nil
+}
+
+func (g *gcsStore) Put(ctx context.Context, email string, platform string, submissionID string, code io.Reader) (string, error) {
+	key := objectKey(email, platform, submissionID)
+	writer := g.client.Bucket(g.bucket).Object(key).NewWriter(ctx)
+	if _, err := io.Copy(writer, code); err != nil {
+		writer.Close()
+		return "", fmt.Errorf("storage: couldnt upload to gcs: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("storage: couldnt finalize gcs upload: %w", err)
+	}
+	return fmt.Sprintf("gcs://%s/%s", g.bucket, key), nil
+}
+
+func (g *gcsStore) Get(ctx context.Context, url string) (io.ReadCloser, error) {
+	key, err := keyFromURL("gcs", g.bucket, url)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := g.client.Bucket(g.bucket).Object(key).NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("storage: couldnt fetch from gcs: %w", err)
+	}
+	return reader, nil
+}
+
+func (g *gcsStore) Delete(ctx context.Context, url string) error {
+	key, err := keyFromURL("gcs", g.bucket, url)
+	if err != nil {
+		return err
+	}
+	if err := g.client.Bucket(g.bucket).Object(key).Delete(ctx); err != nil {
+		return fmt.Errorf("storage: couldnt delete from gcs: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+/**
+* @brief: minioStore is the CodeArtifactStore backend for self-hosted MinIO.
+**/
+
+type minioStore struct {
+	client *minio.Client
+	bucket string
+}
+
+func newMinioStore(cfg StorageConfig) (*minioStore, error) {
+	client, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		Secure: cfg.UseSSL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("storage: couldnt create minio client: %w", err)
+	}
+	return &minioStore{client: client, bucket: cfg.Bucket}, nil
+}
+
+func (m *minioStore) Put(ctx context.Context, email string, platform string, submissionID string, code io.Reader) (string, error) {
+	key := objectKey(email, platform, submissionID)
+	_, err := m.client.PutObject(ctx, m.bucket, key, code, -1, minio.PutObjectOptions{})
+	if err != nil {
+		return "", fmt.Errorf("storage: couldnt upload to minio: %w", err)
+	}
+	return fmt.Sprintf("minio://%s/%s", m.bucket, key), nil
+}
+
+func (m *minioStore) Get(ctx context.Context, url string) (io.ReadCloser, error) {
+	key, err := keyFromURL("minio", m.bucket, url)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := m.client.GetObject(ctx, m.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("storage: couldnt fetch from minio: %w", err)
+	}
+	return obj, nil
+}
+
+func (m *minioStore) Delete(ctx context.Context, url string) error {
+	key, err := keyFromURL("minio", m.bucket, url)
+	if err != nil {
+		return err
+	}
+	if err := m.client.RemoveObject(ctx, m.bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("storage: couldnt delete from minio: %w", err)
+	}
+	return nil
+}
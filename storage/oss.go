@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+/**
+* @brief: ossStore is the CodeArtifactStore backend for Aliyun OSS.
+**/
+
+type ossStore struct {
+	bucket *oss.Bucket
+	name   string
+}
+
+func newOSSStore(cfg StorageConfig) (*ossStore, error) {
+	client, err := oss.New(cfg.Endpoint, cfg.AccessKeyID, cfg.SecretAccessKey)
+	if err != nil {
+		return nil, fmt.Errorf("storage: couldnt create oss client: %w", err)
+	}
+	bucket, err := client.Bucket(cfg.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("storage: couldnt open oss bucket: %w", err)
+	}
+	return &ossStore{bucket: bucket, name: cfg.Bucket}, nil
+}
+
+func (o *ossStore) Put(ctx context.Context, email string, platform string, submissionID string, code io.Reader) (string, error) {
+	key := objectKey(email, platform, submissionID)
+	if err := o.bucket.PutObject(key, code); err != nil {
+		return "", fmt.Errorf("storage: couldnt upload to oss: %w", err)
+	}
+	return fmt.Sprintf("oss://%s/%s", o.name, key), nil
+}
+
+func (o *ossStore) Get(ctx context.Context, url string) (io.ReadCloser, error) {
+	key, err := keyFromURL("oss", o.name, url)
+	if err != nil {
+		return nil, err
+	}
+	body, err := o.bucket.GetObject(key)
+	if err != nil {
+		return nil, fmt.Errorf("storage: couldnt fetch from oss: %w", err)
+	}
+	return body, nil
+}
+
+func (o *ossStore) Delete(ctx context.Context, url string) error {
+	key, err := keyFromURL("oss", o.name, url)
+	if err != nil {
+		return err
+	}
+	if err := o.bucket.DeleteObject(key); err != nil {
+		return fmt.Errorf("storage: couldnt delete from oss: %w", err)
+	}
+	return nil
+}
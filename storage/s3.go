@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+/**
+* @brief: s3Store is the CodeArtifactStore backend for AWS S3.
+**/
+
+type s3Store struct {
+	client   *s3.Client
+	uploader *manager.Uploader
+	bucket   string
+}
+
+func newS3Store(cfg StorageConfig) (*s3Store, error) {
+	awsCfg, err := config.LoadDefaultConfig(context.Background(), config.WithRegion(cfg.Region))
+	if err != nil {
+		return nil, fmt.Errorf("storage: couldnt load aws config: %w", err)
+	}
+	client := s3.NewFromConfig(awsCfg)
+	return &s3Store{
+		client:   client,
+		uploader: manager.NewUploader(client),
+		bucket:   cfg.Bucket,
+	}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, email string, platform string, submissionID string, code io.Reader) (string, error) {
+	key := objectKey(email, platform, submissionID)
+	_, err := s.uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   code,
+	})
+	if err != nil {
+		return "", fmt.Errorf("storage: couldnt upload to s3: %w", err)
+	}
+	return fmt.Sprintf("s3://%s/%s", s.bucket, key), nil
+}
+
+func (s *s3Store) Get(ctx context.Context, url string) (io.ReadCloser, error) {
+	key, err := keyFromURL("s3", s.bucket, url)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("storage: couldnt fetch from s3: %w", err)
+	}
+	return out.Body, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, url string) error {
+	key, err := keyFromURL("s3", s.bucket, url)
+	if err != nil {
+		return err
+	}
+	_, err = s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("storage: couldnt delete from s3: %w", err)
+	}
+	return nil
+}
+
+/**
+* @brief: keyFromURL recovers the object key from a "<scheme>://<bucket>/<key>" URL
+* produced by one of this package's Put implementations.
+**/
+
+func keyFromURL(scheme string, bucket string, url string) (string, error) {
+	prefix := fmt.Sprintf("%s://%s/", scheme, bucket)
+	if !strings.HasPrefix(url, prefix) {
+		return "", fmt.Errorf("storage: url %q doesnt belong to bucket %q", url, bucket)
+	}
+	return url[len(prefix):], nil
+}
@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+/**
+* @brief: Provider identifies which object-storage backend a StorageConfig targets.
+**/
+
+type Provider string
+
+const (
+	ProviderS3    Provider = "s3"
+	ProviderMinio Provider = "minio"
+	ProviderGCS   Provider = "gcs"
+	ProviderOSS   Provider = "oss"
+)
+
+/**
+* @brief: StorageConfig carries everything a CodeArtifactStore implementation needs to
+* talk to its backend. Which fields are required depends on Provider, e.g. Region is
+* ignored by Minio/OSS and Endpoint is ignored by S3/GCS.
+**/
+
+type StorageConfig struct {
+	Provider        Provider
+	Bucket          string
+	Region          string
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	UseSSL          bool
+}
+
+/**
+* @brief: CodeArtifactStore is implemented by every supported object-storage backend.
+* It lets the rest of serviceUtilities persist and retrieve submission code without
+* knowing which bucket/provider is actually in use.
+* @param: ctx - caller context, email/platform/submissionID - used to build the object
+* key, code - the raw source to upload.
+* @return: Put returns the canonical or presigned URL that should be persisted on the
+* SubmissionData document.
+**/
+
+type CodeArtifactStore interface {
+	Put(ctx context.Context, email string, platform string, submissionID string, code io.Reader) (string, error)
+	Get(ctx context.Context, url string) (io.ReadCloser, error)
+	Delete(ctx context.Context, url string) error
+}
+
+/**
+* @brief: NewStore builds the CodeArtifactStore matching config.Provider. An empty
+* Provider yields a nil CodeArtifactStore and a nil error, meaning code storage is disabled.
+* @param: config - the storage configuration selected by the caller.
+* @return: a ready-to-use CodeArtifactStore, or an error if the provider is unknown or
+* misconfigured.
+**/
+
+func NewStore(config StorageConfig) (CodeArtifactStore, error) {
+	switch config.Provider {
+	case ProviderS3:
+		return newS3Store(config)
+	case ProviderMinio:
+		return newMinioStore(config)
+	case ProviderGCS:
+		return newGCSStore(config)
+	case ProviderOSS:
+		return newOSSStore(config)
+	case "":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("storage: unknown provider %q", config.Provider)
+	}
+}
+
+/**
+* @brief: objectKey builds the object key shared by every backend so that artifacts
+* uploaded by one provider can be found the same way under another.
+**/
+
+func objectKey(email string, platform string, submissionID string) string {
+	return fmt.Sprintf("%s/%s/%s.code", email, platform, submissionID)
+}
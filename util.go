@@ -1,15 +1,21 @@
 package serviceUtilities
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
-	"reflect"
 	"strconv"
-	"strings"
 	"time"
 
 	platformDatapb "github.com/NoStalk/protoDefinitions"
+	"github.com/NoStalk/serviceUtilities/cache"
+	"github.com/NoStalk/serviceUtilities/storage"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -68,15 +74,26 @@ type DBResources struct {
 	ctx                context.Context
 	cancel             context.CancelFunc
 	selectedCollection *mongo.Collection
+	codeStore          storage.CodeArtifactStore
+	cache              cache.Cache
+	observerState      *observerState
+	observerEvents     chan observerEvent
 }
 
 /**
-* @brief: This function is used to create a new connection to the database.
-* @param: None.
-* @return: a mongo.Client object, a context object, and a contextCancel function.
+* @brief: This function is used to create a new connection to the database. Database
+* name, collection name, code storage backend and cache are all zero-configured by
+* default (database "UserDB", collection "users", no code store, no cache) and can be
+* overridden with WithDatabaseName, WithCollectionName, WithStorage and WithCache.
+* @param: mongoURI - the connection string, opts - Options to override the defaults.
+* @return: a DBResources ready to serve the Ctx-suffixed functions, or an error.
 **/
 
-func OpenDatabaseConnection(mongoURI string) (DBResources, error) {
+func OpenDatabaseConnection(mongoURI string, opts ...Option) (DBResources, error) {
+	cfg := defaultDBConfig()
+	for _, opt := range opts {
+		opt(&cfg)
+	}
 
 	client, err := mongo.NewClient(options.Client().ApplyURI(mongoURI))
 
@@ -92,145 +109,362 @@ func OpenDatabaseConnection(mongoURI string) (DBResources, error) {
 		cancel()
 		return dbResources, err
 	}
-	selectedCollection := client.Database("UserDB").Collection("users")
+	codeStore, err := storage.NewStore(cfg.storageConfig)
+	if err != nil {
+		log.Printf("Couldnt set up code artifact store: %v", err)
+		client.Disconnect(ctx)
+		cancel()
+		return dbResources, err
+	}
+	lastDataCache, err := cache.NewCache(cfg.cacheConfig)
+	if err != nil {
+		log.Printf("Couldnt set up cache: %v", err)
+		client.Disconnect(ctx)
+		cancel()
+		return dbResources, err
+	}
+	selectedCollection := client.Database(cfg.databaseName).Collection(cfg.collectionName)
 	fmt.Println("Connected to mongodb")
 	dbResources = DBResources{
 		client:             client,
 		ctx:                ctx,
 		cancel:             cancel,
 		selectedCollection: selectedCollection,
+		codeStore:          codeStore,
+		cache:              lastDataCache,
+		observerState:      &observerState{},
+		observerEvents:     make(chan observerEvent, observerQueueSize),
 	}
+	go dbResources.dispatchObserverEvents()
 	return dbResources, nil
 }
 
 /**
-* @brief: This function is used to get the last contest data of a user from the database.
-* @param: email - the email of the user, platform - the platform of the user, dbResources - the database resources.
-* @return: the last contest data of the user.
+* @brief: CacheStats reports hit/miss counters for the read-through cache backing
+* GetLastContest/GetLastSubmission, so operators can see the hit rate. Returns the zero
+* Stats if caching is disabled.
+**/
+
+func (dbResources DBResources) CacheStats() cache.Stats {
+	if dbResources.cache == nil {
+		return cache.Stats{}
+	}
+	return dbResources.cache.Stats()
+}
+
+func lastContestCacheKey(email string, platform string) string {
+	return fmt.Sprintf("last-contest:%s:%s", email, platform)
+}
+
+func lastSubmissionCacheKey(email string, platform string) string {
+	return fmt.Sprintf("last-submission:%s:%s", email, platform)
+}
+
+/**
+* @brief: GetLastContestCtx gets the last contest data of a user from the database. It
+* plumbs the caller-supplied ctx all the way down to Mongo instead of relying on the
+* 10-second timeout captured by OpenDatabaseConnection, which would otherwise fail every
+* call made more than 10 seconds after connecting.
+* @param: ctx - caller context, email - the email of the user, platform - the platform of the user.
+* @return: the last contest data of the user. Returns ErrUnknownPlatform if platform isnt
+* registered, ErrUserNotFound if no user matches email, or ErrNoData if the user has no
+* contests recorded for platform.
 **/
 
-func GetLastContest(email string, platform string, dbResources DBResources) ContestData {
+func (dbResources DBResources) GetLastContestCtx(ctx context.Context, email string, platform string) (ContestData, error) {
+	bsonField, err := bsonFieldFor(platform)
+	if err != nil {
+		return ContestData{}, err
+	}
+
+	cacheKey := lastContestCacheKey(email, platform)
+	if dbResources.cache != nil {
+		if cached, ok, err := dbResources.cache.Get(ctx, cacheKey); err == nil && ok {
+			var contestData ContestData
+			if err := json.Unmarshal(cached, &contestData); err == nil {
+				return contestData, nil
+			}
+		}
+	}
+
 	var documentResult bson.M
 	filter := bson.M{
 		"email": email,
 	}
-	opts := options.FindOne().SetProjection(bson.M{"platformData." + strings.ToLower(platform) + ".contests": 1})
-	err := dbResources.selectedCollection.FindOne(dbResources.ctx, filter, opts).Decode(&documentResult)
-
+	opts := options.FindOne().SetProjection(bson.M{"platformData." + bsonField + ".contests": 1})
+	err = dbResources.selectedCollection.FindOne(ctx, filter, opts).Decode(&documentResult)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ContestData{}, fmt.Errorf("%w: %q", ErrUserNotFound, email)
+	}
 	if err != nil {
-		log.Fatalf("Couldnt find user: %v", err)
+		return ContestData{}, fmt.Errorf("serviceUtilities: couldnt find user: %w", err)
 	}
 	doc, err := bson.Marshal(documentResult)
 	if err != nil {
-		log.Fatalf("Couldnt marshal user: %v", err)
+		return ContestData{}, fmt.Errorf("serviceUtilities: couldnt marshal user: %w", err)
 	}
 	var userObject UserSchema
 	err = bson.Unmarshal(doc, &userObject)
 	if err != nil {
-		log.Fatalf("Couldnt unmarshal user: %v", err)
+		return ContestData{}, fmt.Errorf("serviceUtilities: couldnt unmarshal user: %w", err)
 	}
 
-	platformData := getPlatformDataDynamically(&userObject.PlatformData, platform)
+	platformData, err := GetPlatform(&userObject, platform)
+	if err != nil {
+		return ContestData{}, err
+	}
 
 	if len(platformData.Contests) == 0 {
-		var emptyContestDataStruct = ContestData{}
-		return emptyContestDataStruct
+		return ContestData{}, fmt.Errorf("%w: %s has no contests for %q", ErrNoData, email, platform)
+	}
+	lastContest := platformData.Contests[len(platformData.Contests)-1]
+	if dbResources.cache != nil {
+		if encoded, err := json.Marshal(lastContest); err == nil {
+			dbResources.cache.Set(ctx, cacheKey, encoded, 0)
+		}
 	}
-	return platformData.Contests[len(platformData.Contests)-1]
+	return lastContest, nil
 }
 
 /**
-* @brief: This function is used to get the last submission data of a user from the database.
+* @brief: This function is used to get the last contest data of a user from the database.
 * @param: email - the email of the user, platform - the platform of the user, dbResources - the database resources.
-* @return: the last submission data of the user.
+* @return: the last contest data of the user, or an error if platform isnt registered.
+* @deprecated: Use GetLastContestCtx, which accepts a caller-supplied context.Context and
+* returns an error instead of calling log.Fatalf on Mongo/bson failures.
+**/
+
+func GetLastContest(email string, platform string, dbResources DBResources) (ContestData, error) {
+	contest, err := dbResources.GetLastContestCtx(context.Background(), email, platform)
+	if err != nil {
+		if errors.Is(err, ErrUnknownPlatform) {
+			return ContestData{}, err
+		}
+		if errors.Is(err, ErrNoData) {
+			return ContestData{}, nil
+		}
+		log.Fatalf("Couldnt find user: %v", err)
+	}
+	return contest, nil
+}
+
+/**
+* @brief: GetLastSubmissionCtx gets the last submission data of a user from the database.
+* See GetLastContestCtx for why it takes ctx instead of using DBResources' connect-time
+* context.
+* @param: ctx - caller context, email - the email of the user, platform - the platform of the user.
+* @return: the last submission data of the user. Returns ErrUnknownPlatform if platform
+* isnt registered, ErrUserNotFound if no user matches email, or ErrNoData if the user has
+* no submissions recorded for platform.
 **/
 
-func GetLastSubmission(email string, platform string, dbResources DBResources) SubmissionData {
+func (dbResources DBResources) GetLastSubmissionCtx(ctx context.Context, email string, platform string) (SubmissionData, error) {
+	bsonField, err := bsonFieldFor(platform)
+	if err != nil {
+		return SubmissionData{}, err
+	}
+
+	cacheKey := lastSubmissionCacheKey(email, platform)
+	if dbResources.cache != nil {
+		if cached, ok, err := dbResources.cache.Get(ctx, cacheKey); err == nil && ok {
+			var submissionData SubmissionData
+			if err := json.Unmarshal(cached, &submissionData); err == nil {
+				return submissionData, nil
+			}
+		}
+	}
+
 	var documentResult bson.M
 	filter := bson.M{
 		"email": email,
 	}
-	opts := options.FindOne().SetProjection(bson.M{"platformData." + strings.ToLower(platform) + ".submissions": 1})
-	err := dbResources.selectedCollection.FindOne(dbResources.ctx, filter, opts).Decode(&documentResult)
-
+	opts := options.FindOne().SetProjection(bson.M{"platformData." + bsonField + ".submissions": 1})
+	err = dbResources.selectedCollection.FindOne(ctx, filter, opts).Decode(&documentResult)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return SubmissionData{}, fmt.Errorf("%w: %q", ErrUserNotFound, email)
+	}
 	if err != nil {
-		log.Fatalf("Couldnt find user: %v", err)
+		return SubmissionData{}, fmt.Errorf("serviceUtilities: couldnt find user: %w", err)
 	}
 	doc, err := bson.Marshal(documentResult)
 	if err != nil {
-		log.Fatalf("Couldnt marshal user: %v", err)
+		return SubmissionData{}, fmt.Errorf("serviceUtilities: couldnt marshal user: %w", err)
 	}
 	var userObject UserSchema
 	err = bson.Unmarshal(doc, &userObject)
 	if err != nil {
-		log.Fatalf("Couldnt unmarshal user: %v", err)
+		return SubmissionData{}, fmt.Errorf("serviceUtilities: couldnt unmarshal user: %w", err)
+	}
+	platformData, err := GetPlatform(&userObject, platform)
+	if err != nil {
+		return SubmissionData{}, err
 	}
-	platformData := getPlatformDataDynamically(&userObject.PlatformData, platform)
 
 	if len(platformData.Submissions) == 0 {
-		var emptySubmissionDataStruct SubmissionData = SubmissionData{}
-		return emptySubmissionDataStruct
+		return SubmissionData{}, fmt.Errorf("%w: %s has no submissions for %q", ErrNoData, email, platform)
 	}
 
-	return platformData.Submissions[len(platformData.Submissions)-1]
+	lastSubmission := platformData.Submissions[len(platformData.Submissions)-1]
+	if dbResources.cache != nil {
+		if encoded, err := json.Marshal(lastSubmission); err == nil {
+			dbResources.cache.Set(ctx, cacheKey, encoded, 0)
+		}
+	}
+	return lastSubmission, nil
+}
+
+/**
+* @brief: This function is used to get the last submission data of a user from the database.
+* @param: email - the email of the user, platform - the platform of the user, dbResources - the database resources.
+* @return: the last submission data of the user, or an error if platform isnt registered.
+* @deprecated: Use GetLastSubmissionCtx, which accepts a caller-supplied context.Context
+* and returns an error instead of calling log.Fatalf on Mongo/bson failures.
+**/
 
+func GetLastSubmission(email string, platform string, dbResources DBResources) (SubmissionData, error) {
+	submission, err := dbResources.GetLastSubmissionCtx(context.Background(), email, platform)
+	if err != nil {
+		if errors.Is(err, ErrUnknownPlatform) {
+			return SubmissionData{}, err
+		}
+		if errors.Is(err, ErrNoData) {
+			return SubmissionData{}, nil
+		}
+		log.Fatalf("Couldnt find user: %v", err)
+	}
+	return submission, nil
 }
 
 /**
-* @brief: This function is used to find some user in the database and return user arrays.
-* @param: *mongo.collection.
-* @return: Array of contests objects and submissions objects.
-* @deprecated: This function is deprecated.
-* Deprecated: The function is no longer needed because dont query the entire arrays anymore!!
+* @brief: FindContestsandSubmissionsFromDBCtx finds some user in the database and
+* returns its full contest/submission arrays.
+* @param: ctx - caller context, email - the user to look up, platform - the platform to read.
+* @return: Array of contests objects and submissions objects. Returns ErrUnknownPlatform
+* if platform isnt registered, or ErrUserNotFound if no user matches email.
+* @deprecated: This function is deprecated; dont query the entire arrays anymore.
 **/
 
-func FindContestsandSubmissionsFromDB(dbResources DBResources, email string, platform string) ([]ContestData, []SubmissionData) {
+func (dbResources DBResources) FindContestsandSubmissionsFromDBCtx(ctx context.Context, email string, platform string) ([]ContestData, []SubmissionData, error) {
 	selectedCollection := dbResources.selectedCollection
 	filter := bson.M{"email": email}
-	var userMap map[string]interface{}
 	var result bson.M
-	err := selectedCollection.FindOne(context.TODO(), filter).Decode(&result)
+	err := selectedCollection.FindOne(ctx, filter).Decode(&result)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil, fmt.Errorf("%w: %q", ErrUserNotFound, email)
+	}
 	if err != nil {
-		log.Fatalf("Couldnt find user: %v", err)
+		return nil, nil, fmt.Errorf("serviceUtilities: couldnt find user: %w", err)
 	}
 	doc, err := bson.Marshal(result)
 	if err != nil {
-		log.Fatalf("Couldnt marshal user: %v", err)
+		return nil, nil, fmt.Errorf("serviceUtilities: couldnt marshal user: %w", err)
 	}
 	var userObject UserSchema
 	err = bson.Unmarshal(doc, &userObject)
 	if err != nil {
-		log.Fatalf("Couldnt unmarshal user: %v", err)
+		return nil, nil, fmt.Errorf("serviceUtilities: couldnt unmarshal user: %w", err)
+	}
+
+	platformData, err := GetPlatform(&userObject, platform)
+	if err != nil {
+		return nil, nil, err
 	}
-	err = bson.Unmarshal(doc, &userMap)
+	return platformData.Contests, platformData.Submissions, nil
+}
+
+/**
+* @brief: This function is used to find some user in the database and return user arrays.
+* @param: dbResources, user's email, platform.
+* @return: Array of contests objects and submissions objects, or an error if platform isnt registered.
+* @deprecated: Use FindContestsandSubmissionsFromDBCtx, which accepts a caller-supplied
+* context.Context and returns an error instead of calling log.Fatalf on Mongo/bson failures.
+**/
 
+func FindContestsandSubmissionsFromDB(dbResources DBResources, email string, platform string) ([]ContestData, []SubmissionData, error) {
+	contests, submissions, err := dbResources.FindContestsandSubmissionsFromDBCtx(context.Background(), email, platform)
 	if err != nil {
-		log.Fatalf("Couldnt unmarshal user: %v", err)
+		if errors.Is(err, ErrUnknownPlatform) {
+			return nil, nil, err
+		}
+		log.Fatalf("Couldnt find user: %v", err)
 	}
+	return contests, submissions, nil
+}
 
-	platformData := getPlatformDataDynamically(&userObject.PlatformData, platform)
-	return platformData.Contests, platformData.Submissions
+/**
+* @brief: AppendContestDataCtx updates the user's contest-data in the database.
+* @param: ctx - caller context, email - the user to update, platform - the platform to
+* update, newContestData - the contest entries to append.
+* @return: An error if platform isnt registered or the update fails.
+**/
+
+func (dbResources DBResources) AppendContestDataCtx(ctx context.Context, email string, platform string, newContestData []ContestData) error {
+	bsonField, err := bsonFieldFor(platform)
+	if err != nil {
+		return err
+	}
+
+	selectedCollection := dbResources.selectedCollection
+	updateContestQuery := bson.M{"$push": bson.M{"platformData." + bsonField + ".contests": bson.M{"$each": newContestData}}}
+	filter := bson.M{"email": email}
+
+	_, err = selectedCollection.UpdateOne(ctx, filter, updateContestQuery)
+	if err != nil {
+		return fmt.Errorf("serviceUtilities: couldnt update user: %w", err)
+	}
+	fmt.Println("Updated user")
+	if dbResources.cache != nil {
+		dbResources.cache.Delete(ctx, lastContestCacheKey(email, platform))
+	}
+	dbResources.notifyContestsAppended(email, platform, newContestData)
+	return nil
 }
 
 /**
 * @brief: This function is used to update the user's contest-data in the database.
 * @param: *mongo.collection, user's email, array of contest-data.
 * @return: None.
+* @deprecated: Use AppendContestDataCtx, which accepts a caller-supplied context.Context
+* and returns an error instead of calling log.Fatalf on a failed update.
 **/
 
 func AppendContestData(dbResources DBResources, email string, platform string, newContestData []ContestData) error {
+	err := dbResources.AppendContestDataCtx(context.Background(), email, platform, newContestData)
+	if err != nil {
+		if errors.Is(err, ErrUnknownPlatform) {
+			return err
+		}
+		log.Fatalf("Couldnt update user: %v", err)
+	}
+	return nil
+}
+
+/**
+* @brief: AppendSubmissionDataCtx updates the user's submission-data in the database.
+* @param: ctx - caller context, email - the user to update, platform - the platform to
+* update, newSubmissionData - the submission entries to append.
+* @return: An error if platform isnt registered or the update fails.
+**/
+
+func (dbResources DBResources) AppendSubmissionDataCtx(ctx context.Context, email string, platform string, newSubmissionData []SubmissionData) error {
+	bsonField, err := bsonFieldFor(platform)
+	if err != nil {
+		return err
+	}
+
 	selectedCollection := dbResources.selectedCollection
-	// var updatedContests []ContestData = append(staleContestData, newContestData);
-	updateContestQuery := bson.M{"$push": bson.M{"platformData." + strings.ToLower(platform) + ".contests": bson.M{"$each": newContestData}}}
+	updateSubmissionQuery := bson.M{"$push": bson.M{"platformData." + bsonField + ".submissions": bson.M{"$each": newSubmissionData}}}
 	filter := bson.M{"email": email}
-	// updatedUserSchemaDoc := bson.M{"$set": bson.M{"platformData.leetcode.contests": updatedContestQuery}};
 
-	_, err := selectedCollection.UpdateOne(context.TODO(), filter, updateContestQuery)
+	_, err = selectedCollection.UpdateOne(ctx, filter, updateSubmissionQuery)
 	if err != nil {
-		log.Fatalf("Couldnt update user: %v", err)
-		return err
+		return fmt.Errorf("serviceUtilities: couldnt update user: %w", err)
 	}
 	fmt.Println("Updated user")
+	if dbResources.cache != nil {
+		dbResources.cache.Delete(ctx, lastSubmissionCacheKey(email, platform))
+	}
+	dbResources.notifySubmissionsAppended(email, platform, newSubmissionData)
 	return nil
 }
 
@@ -238,21 +472,87 @@ func AppendContestData(dbResources DBResources, email string, platform string, n
 * @brief: This function is used to update the user's submission-data in the database.
 * @param: *mongo.collection, user's email, array of submission-data.
 * @return: None.
+* @deprecated: Use AppendSubmissionDataCtx, which accepts a caller-supplied
+* context.Context and returns an error instead of calling log.Fatalf on a failed update.
 **/
 
 func AppendSubmissionData(dbResources DBResources, email string, platform string, newSubmissionData []SubmissionData) error {
-	selectedCollection := dbResources.selectedCollection
-	// var updatedSubmissions []SubmissionData = append(staleSubmissionData, newSubmissionData);
-	updateSubmissionQuery := bson.M{"$push": bson.M{"platformData." + strings.ToLower(platform) + ".submissions": bson.M{"$each": newSubmissionData}}}
-	filter := bson.M{"email": email}
-	// updatedUserSchemaDoc := bson.M{"$set": bson.M{"platformData.leetcode.submissions": updatedSubmissionQuery}};
-
-	_, err := selectedCollection.UpdateOne(context.TODO(), filter, updateSubmissionQuery)
+	err := dbResources.AppendSubmissionDataCtx(context.Background(), email, platform, newSubmissionData)
 	if err != nil {
+		if errors.Is(err, ErrUnknownPlatform) {
+			return err
+		}
 		log.Fatalf("Couldnt update user: %v", err)
-		return err
 	}
-	fmt.Println("Updated user")
+	return nil
+}
+
+/**
+* @brief: codeArtifactID derives a stable object-storage key from the submission's
+* actual code bytes rather than its position in the current batch, or any metadata that
+* two distinct submissions (e.g. a same-day resubmit-after-WA in the same language)
+* could share. A colliding key would make the second Put overwrite the first's blob, so
+* the first SubmissionData document's already-persisted CodeUrl would silently start
+* resolving to the second submission's code.
+**/
+
+func codeArtifactID(code []byte) string {
+	sum := sha256.Sum256(code)
+	return hex.EncodeToString(sum[:])
+}
+
+/**
+* @brief: AppendSubmissionDataWithCodeCtx updates the user's submission-data in the
+* database, uploading the accompanying source code through the configured
+* CodeArtifactStore first so that only the resulting URL is persisted on the
+* SubmissionData document. code[i] is matched by index to newSubmissionData[i]; pass a
+* nil entry to skip upload for that submission.
+* @param: ctx - caller context, email - the user to update, platform - the platform to
+* update, newSubmissionData - the submission entries to append, code - the matching code blobs.
+* @return: An error if no code store is configured, an upload fails, or the update fails.
+**/
+
+func (dbResources DBResources) AppendSubmissionDataWithCodeCtx(ctx context.Context, email string, platform string, newSubmissionData []SubmissionData, code []io.Reader) error {
+	if dbResources.codeStore == nil {
+		return errors.New("serviceUtilities: couldnt append submission code: no code artifact store configured")
+	}
+	for i := range newSubmissionData {
+		if i >= len(code) || code[i] == nil {
+			continue
+		}
+		content, err := io.ReadAll(code[i])
+		if err != nil {
+			return fmt.Errorf("serviceUtilities: couldnt read submission code: %w", err)
+		}
+		submissionID := codeArtifactID(content)
+		url, err := dbResources.codeStore.Put(ctx, email, platform, submissionID, bytes.NewReader(content))
+		if err != nil {
+			return fmt.Errorf("serviceUtilities: couldnt upload submission code: %w", err)
+		}
+		newSubmissionData[i].CodeUrl = url
+	}
+	return dbResources.AppendSubmissionDataCtx(ctx, email, platform, newSubmissionData)
+}
+
+/**
+* @brief: This function is used to update the user's submission-data in the database, uploading
+* the accompanying source code through the configured CodeArtifactStore first so that only the
+* resulting URL is persisted on the SubmissionData document. code[i] is matched by index to
+* newSubmissionData[i]; pass a nil entry to skip upload for that submission.
+* @param: dbResources, user's email, platform, array of submission-data, array of code blobs.
+* @return: an error if platform isnt registered; any other failure calls log.Fatalf.
+* @deprecated: Use AppendSubmissionDataWithCodeCtx, which accepts a caller-supplied
+* context.Context and returns an error instead of calling log.Fatalf on failure.
+**/
+
+func AppendSubmissionDataWithCode(dbResources DBResources, email string, platform string, newSubmissionData []SubmissionData, code []io.Reader) error {
+	err := dbResources.AppendSubmissionDataWithCodeCtx(context.Background(), email, platform, newSubmissionData, code)
+	if err != nil {
+		if errors.Is(err, ErrUnknownPlatform) {
+			return err
+		}
+		log.Fatalf("%v", err)
+	}
 	return nil
 }
 
@@ -337,17 +637,7 @@ func CreateGRPCCompleteUserDataResponseFromSubmssionSchema(submissionDataforDB [
 func CloseDatabaseConnection(dbResources DBResources) {
 	dbResources.client.Disconnect(dbResources.ctx)
 	dbResources.cancel()
+	dbResources.closeObserverEvents()
 	fmt.Println("Disconnected from mongodb")
 }
 
-/**
-* @brief: This function is used to dynamically get the platform data from the user object.
-* @param: *UserSchema.Platforms, string.
-* @return: PlatformDataModel.
-**/
-
-func getPlatformDataDynamically(platformData *Platforms, platform string) PlatformDataModel {
-	reflectedValue := reflect.ValueOf(platformData).Elem()
-	fieldValue := reflect.Indirect(reflectedValue).FieldByName(platform)
-	return fieldValue.Interface().(PlatformDataModel)
-}
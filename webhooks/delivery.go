@@ -0,0 +1,229 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+
+	serviceUtilities "github.com/NoStalk/serviceUtilities"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+/**
+* @brief: deliveryJob is one queued HTTP delivery, including everything a worker needs to
+* retry it without going back to Mongo.
+**/
+
+type deliveryJob struct {
+	registration Registration
+	event        Event
+	payload      []byte
+	attempt      int
+}
+
+/**
+* @brief: attemptLog is the per-attempt record persisted to the deliveries collection,
+* regardless of whether the attempt succeeded.
+**/
+
+type attemptLog struct {
+	RegistrationID primitive.ObjectID `bson:"registrationId"`
+	Event          Event              `bson:"event"`
+	Attempt        int                `bson:"attempt"`
+	URL            string             `bson:"url"`
+	StatusCode     int                `bson:"statusCode"`
+	Error          string             `bson:"error,omitempty"`
+	At             time.Time          `bson:"at"`
+}
+
+/**
+* @brief: deadLetter is what's persisted once a delivery has exhausted maxAttempts, kept
+* around so an operator can inspect or ReplayDelivery it.
+**/
+
+type deadLetter struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	RegistrationID primitive.ObjectID `bson:"registrationId"`
+	Event          Event              `bson:"event"`
+	Payload        []byte             `bson:"payload"`
+	Attempts       int                `bson:"attempts"`
+	LastError      string             `bson:"lastError"`
+	At             time.Time          `bson:"at"`
+}
+
+/**
+* @brief: signPayload computes the X-NoStalk-Signature header value: hex-encoded
+* HMAC-SHA256 of the body using the endpoint's registered secret.
+**/
+
+func signPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+/**
+* @brief: OnContestsAppended implements serviceUtilities.PlatformDataObserver. It builds
+* the gRPC-shaped JSON payload and enqueues a delivery for every matching registration.
+**/
+
+func (m *Manager) OnContestsAppended(email string, platform string, new []serviceUtilities.ContestData) {
+	response := serviceUtilities.CreateGRPCContestResponseFromContestSchema(new)
+	payload, err := protojson.Marshal(response)
+	if err != nil {
+		fmt.Printf("webhooks: couldnt marshal contest payload: %v\n", err)
+		return
+	}
+	m.enqueueForEvent(email, platform, EventContestsAppended, payload)
+}
+
+/**
+* @brief: OnSubmissionsAppended implements serviceUtilities.PlatformDataObserver. It
+* builds the gRPC-shaped JSON payload and enqueues a delivery for every matching
+* registration.
+**/
+
+func (m *Manager) OnSubmissionsAppended(email string, platform string, new []serviceUtilities.SubmissionData) {
+	response := serviceUtilities.CreateGRPCSubmissionResponseFromSubmissionSchema(new)
+	payload, err := protojson.Marshal(response)
+	if err != nil {
+		fmt.Printf("webhooks: couldnt marshal submission payload: %v\n", err)
+		return
+	}
+	m.enqueueForEvent(email, platform, EventSubmissionsAppended, payload)
+}
+
+func (m *Manager) enqueueForEvent(email string, platform string, event Event, payload []byte) {
+	registrations, err := m.matchingRegistrations(context.Background(), email, platform, event)
+	if err != nil {
+		fmt.Printf("webhooks: %v\n", err)
+		return
+	}
+	for _, registration := range registrations {
+		m.enqueue(deliveryJob{registration: registration, event: event, payload: payload, attempt: 1})
+	}
+}
+
+func (m *Manager) enqueue(job deliveryJob) {
+	m.closeMu.Lock()
+	defer m.closeMu.Unlock()
+	if m.closed {
+		return
+	}
+	select {
+	case m.queue <- job:
+	default:
+		fmt.Println("webhooks: delivery queue full, dropping delivery")
+	}
+}
+
+/**
+* @brief: worker drains the delivery queue. One stuck/slow endpoint only occupies its
+* own worker, so a pool of them keeps a single misbehaving consumer from starving the
+* rest.
+**/
+
+func (m *Manager) worker() {
+	for job := range m.queue {
+		m.deliver(job)
+	}
+}
+
+func (m *Manager) deliver(job deliveryJob) {
+	req, err := http.NewRequest(http.MethodPost, job.registration.URL, bytes.NewReader(job.payload))
+	if err != nil {
+		m.logAttempt(job, 0, err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-NoStalk-Signature", signPayload(job.registration.Secret, job.payload))
+
+	resp, err := m.httpClient.Do(req)
+	if err == nil {
+		resp.Body.Close()
+	}
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	m.logAttempt(job, statusCode, err)
+
+	if err == nil && statusCode >= 200 && statusCode < 300 {
+		return
+	}
+
+	if job.attempt >= m.maxAttempts {
+		m.sendToDeadLetter(job, err)
+		return
+	}
+
+	time.AfterFunc(backoffWithJitter(job.attempt), func() {
+		m.enqueue(deliveryJob{
+			registration: job.registration,
+			event:        job.event,
+			payload:      job.payload,
+			attempt:      job.attempt + 1,
+		})
+	})
+}
+
+func (m *Manager) logAttempt(job deliveryJob, statusCode int, err error) {
+	entry := attemptLog{
+		RegistrationID: job.registration.ID,
+		Event:          job.event,
+		Attempt:        job.attempt,
+		URL:            job.registration.URL,
+		StatusCode:     statusCode,
+		At:             time.Now(),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	if _, insertErr := m.deliveries.InsertOne(context.Background(), entry); insertErr != nil {
+		fmt.Printf("webhooks: couldnt log delivery attempt: %v\n", insertErr)
+	}
+}
+
+func (m *Manager) sendToDeadLetter(job deliveryJob, lastErr error) {
+	letter := deadLetter{
+		RegistrationID: job.registration.ID,
+		Event:          job.event,
+		Payload:        job.payload,
+		Attempts:       job.attempt,
+		At:             time.Now(),
+	}
+	if lastErr != nil {
+		letter.LastError = lastErr.Error()
+	}
+	if _, err := m.deadLetters.InsertOne(context.Background(), letter); err != nil {
+		fmt.Printf("webhooks: couldnt record dead letter: %v\n", err)
+	}
+}
+
+/**
+* @brief: ReplayDelivery re-queues a dead-lettered delivery for a fresh attempt cycle.
+* @param: ctx - caller context, id - the dead letter's _id.
+* @return: an error if the dead letter couldnt be found or removed.
+**/
+
+func (m *Manager) ReplayDelivery(ctx context.Context, id primitive.ObjectID) error {
+	var letter deadLetter
+	err := m.deadLetters.FindOneAndDelete(ctx, map[string]interface{}{"_id": id}).Decode(&letter)
+	if err != nil {
+		return fmt.Errorf("webhooks: couldnt find dead letter %s: %w", id.Hex(), err)
+	}
+
+	var registration Registration
+	if err := m.registrations.FindOne(ctx, map[string]interface{}{"_id": letter.RegistrationID}).Decode(&registration); err != nil {
+		return fmt.Errorf("webhooks: couldnt find registration %s: %w", letter.RegistrationID.Hex(), err)
+	}
+
+	m.enqueue(deliveryJob{registration: registration, event: letter.Event, payload: letter.Payload, attempt: 1})
+	return nil
+}
@@ -0,0 +1,187 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+/**
+* @brief: Event identifies which kind of append a webhook registration wants delivered.
+**/
+
+type Event string
+
+const (
+	EventContestsAppended    Event = "contests_appended"
+	EventSubmissionsAppended Event = "submissions_appended"
+)
+
+/**
+* @brief: Registration is a single operator-configured HTTP endpoint, persisted in the
+* webhooks collection. Email and Platform are optional filters: an empty Email means
+* "any user", an empty Platform means "any platform".
+**/
+
+type Registration struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Email     string             `bson:"email"`
+	Platform  string             `bson:"platform"`
+	URL       string             `bson:"url"`
+	Secret    string             `bson:"secret"`
+	Events    []Event            `bson:"events"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
+
+/**
+* @brief: Manager owns the registrations collection, the delivery/dead-letter log, and
+* the worker pool that drains queued deliveries. It implements
+* serviceUtilities.PlatformDataObserver so it can be handed straight to
+* DBResources.Subscribe.
+**/
+
+type Manager struct {
+	registrations *mongo.Collection
+	deliveries    *mongo.Collection
+	deadLetters   *mongo.Collection
+	httpClient    *http.Client
+	queue         chan deliveryJob
+	maxAttempts   int
+
+	// closeMu guards closed so enqueue and Close can't race: a retry scheduled via
+	// time.AfterFunc can still fire after Close, and sending on a closed queue panics
+	// even through a select with a default case.
+	closeMu sync.Mutex
+	closed  bool
+}
+
+/**
+* @brief: Config controls worker pool size and retry behaviour for a Manager.
+**/
+
+type Config struct {
+	Workers     int
+	MaxAttempts int
+	QueueSize   int
+}
+
+func defaultConfig(cfg Config) Config {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 4
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 5
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 256
+	}
+	return cfg
+}
+
+/**
+* @brief: NewManager builds a Manager backed by the given database and starts its worker
+* pool. Callers are responsible for calling Close when done.
+* @param: db - the database holding the webhooks/webhookDeliveries/webhookDeadLetters
+* collections, cfg - worker pool and retry tuning.
+* @return: a ready-to-use Manager.
+**/
+
+func NewManager(db *mongo.Database, cfg Config) *Manager {
+	cfg = defaultConfig(cfg)
+	m := &Manager{
+		registrations: db.Collection("webhooks"),
+		deliveries:    db.Collection("webhookDeliveries"),
+		deadLetters:   db.Collection("webhookDeadLetters"),
+		httpClient:    &http.Client{Timeout: 10 * time.Second},
+		queue:         make(chan deliveryJob, cfg.QueueSize),
+		maxAttempts:   cfg.MaxAttempts,
+	}
+	for i := 0; i < cfg.Workers; i++ {
+		go m.worker()
+	}
+	return m
+}
+
+/**
+* @brief: Close stops accepting new deliveries and lets in-flight workers drain. Safe to
+* call even with retries still pending via time.AfterFunc: any that fire afterwards see
+* closed and no-op instead of sending on the now-closed queue.
+**/
+
+func (m *Manager) Close() {
+	m.closeMu.Lock()
+	defer m.closeMu.Unlock()
+	if m.closed {
+		return
+	}
+	m.closed = true
+	close(m.queue)
+}
+
+/**
+* @brief: RegisterWebhook persists a new endpoint that will receive JSON payloads for
+* the given events.
+* @param: ctx - caller context, email/platform - optional filters, url - delivery
+* endpoint, secret - used to sign deliveries, events - which events to deliver.
+* @return: the stored Registration, or an error if the insert failed.
+**/
+
+func RegisterWebhook(ctx context.Context, manager *Manager, email string, platform string, url string, secret string, events ...Event) (*Registration, error) {
+	reg := &Registration{
+		Email:     email,
+		Platform:  platform,
+		URL:       url,
+		Secret:    secret,
+		Events:    events,
+		CreatedAt: time.Now(),
+	}
+	result, err := manager.registrations.InsertOne(ctx, reg)
+	if err != nil {
+		return nil, fmt.Errorf("webhooks: couldnt register webhook: %w", err)
+	}
+	reg.ID = result.InsertedID.(primitive.ObjectID)
+	return reg, nil
+}
+
+/**
+* @brief: matchingRegistrations finds every registration that wants to hear about event
+* for the given email/platform, including the wildcard (empty email/platform) entries.
+**/
+
+func (m *Manager) matchingRegistrations(ctx context.Context, email string, platform string, event Event) ([]Registration, error) {
+	filter := bson.M{
+		"email":    bson.M{"$in": []string{"", email}},
+		"platform": bson.M{"$in": []string{"", platform}},
+		"events":   event,
+	}
+	cursor, err := m.registrations.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("webhooks: couldnt look up registrations: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var registrations []Registration
+	if err := cursor.All(ctx, &registrations); err != nil {
+		return nil, fmt.Errorf("webhooks: couldnt decode registrations: %w", err)
+	}
+	return registrations, nil
+}
+
+/**
+* @brief: backoffWithJitter returns how long to wait after failed delivery attempt number
+* attempt (1-indexed) before the next one, doubling each time and adding up to 20% jitter so that a burst of
+* failing deliveries doesnt retry in lockstep.
+**/
+
+func backoffWithJitter(attempt int) time.Duration {
+	base := time.Duration(1<<uint(attempt-1)) * time.Second
+	jitter := time.Duration(rand.Int63n(int64(base) / 5))
+	return base + jitter
+}